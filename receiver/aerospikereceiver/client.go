@@ -0,0 +1,381 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aerospikereceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/aerospikereceiver"
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	as "github.com/aerospike/aerospike-client-go/v6"
+	"go.uber.org/multierr"
+)
+
+// MetricsMap is a single info response, parsed into key/value pairs, e.g. the
+// response to a "namespace/test" request.
+type MetricsMap = map[string]string
+
+// ClusterInfo maps node name to that node's top level info metrics.
+type ClusterInfo map[string]MetricsMap
+
+// NamespaceInfo maps node name to namespace name to that namespace's info metrics, as reported by that node.
+type NamespaceInfo map[string]map[string]MetricsMap
+
+// SetsInfo maps node name to namespace name to set name to that set's info metrics.
+type SetsInfo map[string]map[string]map[string]MetricsMap
+
+// XDRInfo maps node name to XDR datacenter name to that datacenter's info metrics, as reported by that node.
+type XDRInfo map[string]map[string]MetricsMap
+
+// LatencyBucket holds the parsed fields of a single "latencies:" histogram line for one
+// namespace/operation pair.
+type LatencyBucket struct {
+	// TotalOpsPerSec is the total throughput reported for this histogram, in ops/sec.
+	TotalOpsPerSec float64
+	// ExceedPcts holds, for each power-of-two millisecond boundary from 1ms to 65536ms, the
+	// percentage of ops that took longer than that boundary.
+	ExceedPcts []float64
+}
+
+// LatencyInfo maps node name to namespace name to operation name (read, write, udf, batch-index)
+// to that operation's latency histogram, as reported by that node.
+type LatencyInfo map[string]map[string]map[string]LatencyBucket
+
+// Aerospike is the interface used by the scraper to talk to a cluster. It
+// exists so that tests can swap in a mock implementation.
+type Aerospike interface {
+	// Info returns the top-level info metrics for every node in the cluster.
+	Info() (ClusterInfo, error)
+	// NamespaceInfo returns the namespace-level info metrics for every node in the cluster.
+	NamespaceInfo() (NamespaceInfo, error)
+	// SetInfo returns the set-level info metrics for every set in every namespace, for every node in the cluster.
+	SetInfo() (SetsInfo, error)
+	// XDRInfo returns the per-datacenter XDR replication metrics for every node in the cluster.
+	XDRInfo() (XDRInfo, error)
+	// LatencyInfo returns the per-namespace, per-operation latency histograms for every node in the cluster.
+	LatencyInfo() (LatencyInfo, error)
+	// Close tears down any connections held by the client.
+	Close() error
+}
+
+// clusterFactoryFunc builds an Aerospike client connected to the cluster reachable via seeds,
+// using policy to configure TLS and authentication. The underlying client then discovers the
+// rest of the cluster's nodes automatically.
+type clusterFactoryFunc func(seeds []Endpoint, policy *as.ClientPolicy) (Aerospike, error)
+
+// defaultASClient is the production implementation of Aerospike, backed by the official Go client.
+type defaultASClient struct {
+	client *as.Client
+}
+
+func newASClient(seeds []Endpoint, policy *as.ClientPolicy) (Aerospike, error) {
+	hosts := make([]*as.Host, 0, len(seeds))
+	for _, seed := range seeds {
+		hosts = append(hosts, as.NewHost(seed.Host, seed.Port))
+	}
+
+	client, err := as.NewClientWithPolicyAndHost(policy, hosts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to aerospike cluster via seeds %v: %w", seeds, err)
+	}
+
+	return &defaultASClient{client: client}, nil
+}
+
+func (c *defaultASClient) Info() (ClusterInfo, error) {
+	info := make(ClusterInfo)
+	for _, node := range c.client.GetNodes() {
+		res, err := node.RequestInfo("node", "statistics")
+		if err != nil {
+			return nil, err
+		}
+
+		metrics := make(MetricsMap)
+		parseInfoKVs(res["node"], metrics)
+		parseInfoKVs(res["statistics"], metrics)
+		info[node.GetName()] = metrics
+	}
+
+	return info, nil
+}
+
+func (c *defaultASClient) NamespaceInfo() (NamespaceInfo, error) {
+	info := make(NamespaceInfo)
+	for _, node := range c.client.GetNodes() {
+		namespacesRes, err := node.RequestInfo("namespaces")
+		if err != nil {
+			return nil, err
+		}
+
+		namespaces := strings.Split(strings.TrimSpace(namespacesRes["namespaces"]), ";")
+		nodeInfo := make(map[string]MetricsMap, len(namespaces))
+		for _, ns := range namespaces {
+			if ns == "" {
+				continue
+			}
+
+			res, err := node.RequestInfo("namespace/" + ns)
+			if err != nil {
+				return nil, err
+			}
+
+			metrics := make(MetricsMap)
+			parseInfoKVs(res["namespace/"+ns], metrics)
+			nodeInfo[ns] = metrics
+		}
+
+		info[node.GetName()] = nodeInfo
+	}
+
+	return info, nil
+}
+
+func (c *defaultASClient) SetInfo() (SetsInfo, error) {
+	info := make(SetsInfo)
+	for _, node := range c.client.GetNodes() {
+		namespacesRes, err := node.RequestInfo("namespaces")
+		if err != nil {
+			return nil, err
+		}
+
+		namespaces := strings.Split(strings.TrimSpace(namespacesRes["namespaces"]), ";")
+		nodeInfo := make(map[string]map[string]MetricsMap, len(namespaces))
+		for _, ns := range namespaces {
+			if ns == "" {
+				continue
+			}
+
+			res, err := node.RequestInfo("sets/" + ns)
+			if err != nil {
+				return nil, err
+			}
+
+			nodeInfo[ns] = parseSetsInfo(res["sets/"+ns])
+		}
+
+		info[node.GetName()] = nodeInfo
+	}
+
+	return info, nil
+}
+
+// parseSetsInfo parses the response to a "sets/<namespace>" info command, which
+// reports one ";"-delimited entry per set, each itself a ":"-delimited list of
+// "key=value" pairs including the set's own name under the "set" key.
+func parseSetsInfo(res string) map[string]MetricsMap {
+	sets := make(map[string]MetricsMap)
+	for _, entry := range strings.Split(res, ";") {
+		if entry == "" {
+			continue
+		}
+
+		metrics := make(MetricsMap)
+		for _, pair := range strings.Split(entry, ":") {
+			kv := strings.SplitN(pair, "=", 2)
+			if len(kv) != 2 {
+				continue
+			}
+
+			metrics[kv[0]] = kv[1]
+		}
+
+		setName, ok := metrics["set"]
+		if !ok {
+			continue
+		}
+
+		sets[setName] = metrics
+	}
+
+	return sets
+}
+
+func (c *defaultASClient) XDRInfo() (XDRInfo, error) {
+	info := make(XDRInfo)
+	var errs error
+
+	for _, node := range c.client.GetNodes() {
+		cfgRes, err := node.RequestInfo("get-config:context=xdr")
+		if err != nil {
+			errs = multierr.Append(errs, err)
+			continue
+		}
+
+		dcs := parseXDRDatacenters(cfgRes["get-config:context=xdr"])
+		nodeInfo := make(map[string]MetricsMap, len(dcs))
+		for _, dc := range dcs {
+			statsKey := fmt.Sprintf("get-stats:context=xdr;dc=%s", dc)
+			statsRes, err := node.RequestInfo(statsKey)
+			if err != nil {
+				errs = multierr.Append(errs, fmt.Errorf("failed to get xdr stats for dc %s: %w", dc, err))
+				continue
+			}
+
+			metrics := make(MetricsMap)
+			parseInfoKVs(statsRes[statsKey], metrics)
+			nodeInfo[dc] = metrics
+		}
+
+		info[node.GetName()] = nodeInfo
+	}
+
+	return info, errs
+}
+
+// parseXDRDatacenters parses the response to a "get-config:context=xdr" info
+// command, which reports the configured datacenters as a single
+// "dcs=DC1,DC2" entry.
+func parseXDRDatacenters(res string) []string {
+	cfg := make(MetricsMap)
+	parseInfoKVs(res, cfg)
+
+	dcsStr, ok := cfg["dcs"]
+	if !ok || dcsStr == "" {
+		return nil
+	}
+
+	return strings.Split(dcsStr, ",")
+}
+
+func (c *defaultASClient) LatencyInfo() (LatencyInfo, error) {
+	info := make(LatencyInfo)
+	for _, node := range c.client.GetNodes() {
+		res, err := node.RequestInfo("latencies:")
+		if err != nil {
+			return nil, err
+		}
+
+		nodeInfo, err := parseLatencies(res["latencies:"])
+		if err != nil {
+			return nil, err
+		}
+
+		info[node.GetName()] = nodeInfo
+	}
+
+	return info, nil
+}
+
+// parseLatencies parses the response to a "latencies:" info command. Each entry has the
+// form "{namespace}-<operation>:msec,<total_ops_per_sec>,<pct>>1ms,<pct>>2ms,...".
+func parseLatencies(res string) (map[string]map[string]LatencyBucket, error) {
+	info := make(map[string]map[string]LatencyBucket)
+	for _, entry := range strings.Split(res, ";") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		keyValue := strings.SplitN(entry, ":", 2)
+		if len(keyValue) != 2 {
+			continue
+		}
+
+		nsOp := strings.SplitN(keyValue[0], "-", 2)
+		if len(nsOp) != 2 {
+			continue
+		}
+
+		ns := strings.TrimSuffix(strings.TrimPrefix(nsOp[0], "{"), "}")
+		op := nsOp[1]
+
+		// fields[0] is the unit (msec); fields[1] is the total ops/sec; the rest are
+		// cumulative exceed percentages, one per bucket boundary.
+		fields := strings.Split(keyValue[1], ",")
+		if len(fields) < 2 {
+			continue
+		}
+
+		totalOpsPerSec, err := strconv.ParseFloat(fields[1], 64)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse total ops/sec for %s: %w", entry, err)
+		}
+
+		pcts := make([]float64, 0, len(fields)-2)
+		for _, f := range fields[2:] {
+			pct, err := strconv.ParseFloat(f, 64)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse latency percentage for %s: %w", entry, err)
+			}
+
+			pcts = append(pcts, pct)
+		}
+
+		if _, ok := info[ns]; !ok {
+			info[ns] = make(map[string]LatencyBucket)
+		}
+
+		info[ns][op] = LatencyBucket{TotalOpsPerSec: totalOpsPerSec, ExceedPcts: pcts}
+	}
+
+	return info, nil
+}
+
+func (c *defaultASClient) Close() error {
+	c.client.Close()
+	return nil
+}
+
+// parseInfoKVs parses a ";"-delimited, "key=value"-separated info response
+// into dst, e.g. "mem_free=100;mem_used=200".
+func parseInfoKVs(res string, dst MetricsMap) {
+	for _, pair := range strings.Split(res, ";") {
+		if pair == "" {
+			continue
+		}
+
+		kv := strings.SplitN(pair, "=", 2)
+		if len(kv) != 2 {
+			continue
+		}
+
+		dst[kv[0]] = kv[1]
+	}
+}
+
+// clientPolicyFromConfig builds the as.ClientPolicy used to connect to the
+// cluster, translating the receiver's TLS and Auth settings.
+func clientPolicyFromConfig(cfg *Config) (*as.ClientPolicy, error) {
+	policy := as.NewClientPolicy()
+
+	if cfg.Timeout > 0 {
+		policy.Timeout = cfg.Timeout
+	}
+
+	if cfg.TLS != nil {
+		tlsCfg, err := cfg.TLS.LoadTLSConfig()
+		if err != nil {
+			return nil, fmt.Errorf("failed to load TLS config: %w", err)
+		}
+
+		policy.TlsConfig = tlsCfg
+	}
+
+	if cfg.Auth != nil {
+		policy.User = cfg.Auth.Username
+		policy.Password = cfg.Auth.Password
+
+		switch cfg.Auth.AuthMode {
+		case AuthModeExternal:
+			policy.AuthMode = as.AuthModeExternal
+		case AuthModePKI:
+			policy.AuthMode = as.AuthModePKI
+		case AuthModeInternal, "":
+			policy.AuthMode = as.AuthModeInternal
+		}
+	}
+
+	return policy, nil
+}
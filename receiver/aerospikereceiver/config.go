@@ -0,0 +1,143 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aerospikereceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/aerospikereceiver"
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/configtls"
+	"go.opentelemetry.io/collector/receiver/scraperhelper"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/aerospikereceiver/internal/metadata"
+)
+
+// Endpoint is a host/port pair used to seed Aerospike cluster discovery.
+type Endpoint struct {
+	Host string `mapstructure:"host"`
+	Port int    `mapstructure:"port"`
+}
+
+// AuthMode selects how the receiver identifies itself to a cluster that has
+// security enabled. It corresponds to the auth modes supported by the
+// Aerospike Go client's ClientPolicy.AuthMode.
+type AuthMode string
+
+const (
+	// AuthModeInternal authenticates against Aerospike's own user database. This is the default.
+	AuthModeInternal AuthMode = "internal"
+	// AuthModeExternal authenticates against an external LDAP server. Requires TLS.
+	AuthModeExternal AuthMode = "external"
+	// AuthModePKI authenticates using the client certificate presented during the TLS handshake.
+	// Username and password are not required in this mode.
+	AuthModePKI AuthMode = "pki"
+)
+
+// AuthConfig holds the credentials used to authenticate to an Aerospike
+// cluster that has security enabled.
+type AuthConfig struct {
+	Username string   `mapstructure:"username"`
+	Password string   `mapstructure:"password"`
+	AuthMode AuthMode `mapstructure:"auth_mode"`
+}
+
+// Config is the configuration for the aerospike receiver
+type Config struct {
+	scraperhelper.ScraperControllerSettings `mapstructure:",squash"`
+	metadata.MetricsSettings                `mapstructure:",squash"`
+
+	// Endpoint is the address:port of one node in the Aerospike cluster. This
+	// node is used to discover the rest of the cluster. Deprecated in favor of Seeds,
+	// which accepts multiple nodes so that discovery still succeeds if any one of them
+	// is down; Endpoint is treated as an additional seed when Seeds is also set.
+	Endpoint string `mapstructure:"endpoint"`
+
+	// Seeds lists the seed nodes used to bootstrap cluster discovery. The underlying
+	// client connects to any reachable seed and then discovers the rest of the cluster's
+	// nodes automatically. At least one of Endpoint or Seeds must be set.
+	Seeds []Endpoint `mapstructure:"seeds"`
+
+	// Timeout is the timeout applied to each info request made against the cluster.
+	Timeout time.Duration `mapstructure:"timeout"`
+
+	// CollectClusterMetrics determines whether to collect cluster-wide metrics (namespace-level, etc.)
+	// in addition to node-level metrics. Defaults to false.
+	CollectClusterMetrics bool `mapstructure:"collect_cluster_metrics"`
+
+	// CollectXDRMetrics determines whether to collect per-datacenter XDR (Cross-Datacenter Replication)
+	// metrics. Defaults to false.
+	CollectXDRMetrics bool `mapstructure:"collect_xdr_metrics"`
+
+	// TLS configures the client's TLS connection to the cluster, if the cluster requires TLS.
+	TLS *configtls.TLSClientSetting `mapstructure:"tls"`
+
+	// Auth configures the credentials used to authenticate to a cluster with security enabled.
+	Auth *AuthConfig `mapstructure:"auth"`
+}
+
+var _ component.Config = (*Config)(nil)
+
+// Validate validates the aerospike receiver config.
+func (c *Config) Validate() error {
+	if _, err := c.seedEndpoints(); err != nil {
+		return err
+	}
+
+	if c.Auth != nil {
+		switch c.Auth.AuthMode {
+		case "", AuthModeInternal, AuthModeExternal, AuthModePKI:
+		default:
+			return fmt.Errorf("auth_mode must be one of 'internal', 'external', or 'pki', got %q", c.Auth.AuthMode)
+		}
+
+		if c.Auth.AuthMode == AuthModeExternal && c.TLS == nil {
+			return fmt.Errorf("auth_mode 'external' requires tls to be configured")
+		}
+	}
+
+	return nil
+}
+
+// seedEndpoints returns the full set of seed nodes used to bootstrap cluster discovery,
+// combining the legacy single Endpoint field with Seeds. At least one of Endpoint or Seeds
+// must be set.
+func (c *Config) seedEndpoints() ([]Endpoint, error) {
+	seeds := make([]Endpoint, 0, len(c.Seeds)+1)
+
+	if c.Endpoint != "" {
+		host, portStr, err := net.SplitHostPort(c.Endpoint)
+		if err != nil {
+			return nil, err
+		}
+
+		port, err := strconv.Atoi(portStr)
+		if err != nil {
+			return nil, err
+		}
+
+		seeds = append(seeds, Endpoint{Host: host, Port: port})
+	}
+
+	seeds = append(seeds, c.Seeds...)
+
+	if len(seeds) == 0 {
+		return nil, fmt.Errorf("must specify at least one of endpoint or seeds")
+	}
+
+	return seeds, nil
+}
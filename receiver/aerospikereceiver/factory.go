@@ -0,0 +1,60 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aerospikereceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/aerospikereceiver"
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/receiver/scraperhelper"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/aerospikereceiver/internal/metadata"
+)
+
+const (
+	typeStr = "aerospike"
+
+	defaultEndpoint = "localhost:3000"
+)
+
+// NewFactory creates a factory for the Aerospike receiver.
+func NewFactory() component.ReceiverFactory {
+	return component.NewReceiverFactory(
+		typeStr,
+		createDefaultConfig,
+		component.WithMetricsReceiver(createMetricsReceiver, component.StabilityLevelBeta))
+}
+
+func createDefaultConfig() component.Config {
+	return &Config{
+		ScraperControllerSettings: scraperhelper.NewDefaultScraperControllerSettings(typeStr),
+		MetricsSettings:           metadata.DefaultMetricsSettings(),
+		Endpoint:                  defaultEndpoint,
+		Timeout:                   20 * time.Second,
+		CollectClusterMetrics:     false,
+	}
+}
+
+func createMetricsReceiver(
+	_ context.Context,
+	settings component.ReceiverCreateSettings,
+	config component.Config,
+	consumer consumer.Metrics,
+) (component.MetricsReceiver, error) {
+	cfg := config.(*Config)
+	return newAerospikeReceiver(settings, cfg, consumer)
+}
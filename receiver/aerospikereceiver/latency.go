@@ -0,0 +1,111 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aerospikereceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/aerospikereceiver"
+
+import (
+	"math"
+	"time"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/aerospikereceiver/internal/metadata"
+)
+
+// latencyBucketBoundsMs are the upper bounds, in milliseconds, of the
+// aerospike.namespace.latency histogram buckets. They must match
+// aerospikeNamespaceLatencyBucketBoundaries in internal/metadata.
+var latencyBucketBoundsMs = []float64{1, 2, 4, 8, 16, 32, 64, 128, 256, 512, 1024, 2048, 4096, 8192, 16384, 32768, 65536}
+
+// operationAttribute maps an operation name reported by the "latencies:" info command
+// to its corresponding metric attribute value.
+func operationAttribute(op string) (metadata.AttributeOperation, bool) {
+	switch op {
+	case "read":
+		return metadata.AttributeOperationRead, true
+	case "write":
+		return metadata.AttributeOperationWrite, true
+	case "udf":
+		return metadata.AttributeOperationUdf, true
+	case "batch-index":
+		return metadata.AttributeOperationBatchIndex, true
+	default:
+		return 0, false
+	}
+}
+
+// latencyBucketCounts converts the cumulative exceed-percentages reported by the
+// "latencies:" info command into per-bucket counts for an explicit-bounds histogram
+// with boundaries latencyBucketBoundsMs. Negative deltas, which occur when the
+// underlying counters reset between scrapes, are clamped to zero.
+func latencyBucketCounts(totalOpsPerSec float64, exceedPcts []float64, scrapeInterval time.Duration) []uint64 {
+	totalOps := totalOpsPerSec * scrapeInterval.Seconds()
+
+	counts := make([]uint64, 0, len(exceedPcts)+1)
+
+	prevPct := 100.0
+	for _, pct := range exceedPcts {
+		counts = append(counts, countFromPct(totalOps, prevPct-pct))
+		prevPct = pct
+	}
+
+	counts = append(counts, countFromPct(totalOps, prevPct))
+
+	return counts
+}
+
+func countFromPct(totalOps, pct float64) uint64 {
+	if pct <= 0 {
+		return 0
+	}
+
+	return uint64(math.Round(totalOps * pct / 100))
+}
+
+// latencySumEstimate estimates the total latency represented by bucketCounts, using the
+// midpoint of each bucket's boundaries as a representative value. bucketCounts must have
+// len(latencyBucketBoundsMs)+1 entries.
+func latencySumEstimate(bucketCounts []uint64) float64 {
+	var sum float64
+
+	lowerBound := 0.0
+	for i, count := range bucketCounts {
+		if count == 0 {
+			lowerBound = latencyBoundAt(i)
+			continue
+		}
+
+		var representative float64
+		if i == len(bucketCounts)-1 {
+			// Overflow bucket: there is no upper boundary, so use the last known
+			// boundary as a conservative estimate.
+			representative = latencyBucketBoundsMs[len(latencyBucketBoundsMs)-1]
+		} else {
+			representative = (lowerBound + latencyBoundAt(i)) / 2
+		}
+
+		sum += representative * float64(count)
+		lowerBound = latencyBoundAt(i)
+	}
+
+	return sum
+}
+
+// latencyBoundAt returns the upper boundary of bucket i, where bucket
+// len(latencyBucketBoundsMs) is the unbounded overflow bucket.
+func latencyBoundAt(i int) float64 {
+	if i >= len(latencyBucketBoundsMs) {
+		return latencyBucketBoundsMs[len(latencyBucketBoundsMs)-1]
+	}
+
+	return latencyBucketBoundsMs[i]
+}
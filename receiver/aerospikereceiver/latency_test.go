@@ -0,0 +1,94 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aerospikereceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/aerospikereceiver"
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/aerospikereceiver/internal/metadata"
+)
+
+func TestLatencyBucketCounts(t *testing.T) {
+	testCases := []struct {
+		name           string
+		totalOpsPerSec float64
+		exceedPcts     []float64
+		scrapeInterval time.Duration
+		expected       []uint64
+	}{
+		{
+			name:           "evenly spaced percentages",
+			totalOpsPerSec: 1000,
+			exceedPcts:     []float64{95, 90, 85, 80, 75, 70, 65, 60, 55, 50, 45, 40, 35, 30, 25, 20, 15},
+			scrapeInterval: 10 * time.Second,
+			expected:       []uint64{500, 500, 500, 500, 500, 500, 500, 500, 500, 500, 500, 500, 500, 500, 500, 500, 500, 1500},
+		},
+		{
+			name:           "no traffic exceeds any boundary",
+			totalOpsPerSec: 1000,
+			exceedPcts:     []float64{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0},
+			scrapeInterval: 10 * time.Second,
+			expected:       []uint64{10000, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0},
+		},
+		{
+			name:           "all traffic exceeds every boundary",
+			totalOpsPerSec: 1000,
+			exceedPcts:     []float64{100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100},
+			scrapeInterval: 10 * time.Second,
+			expected:       []uint64{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 10000},
+		},
+		{
+			name:           "counter reset produces a negative delta, clamped to zero",
+			totalOpsPerSec: 1000,
+			exceedPcts:     []float64{10, 20, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5},
+			scrapeInterval: 10 * time.Second,
+			expected:       []uint64{9000, 0, 1500, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 500},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := latencyBucketCounts(tc.totalOpsPerSec, tc.exceedPcts, tc.scrapeInterval)
+			require.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestOperationAttribute(t *testing.T) {
+	testCases := []struct {
+		op       string
+		expected metadata.AttributeOperation
+		ok       bool
+	}{
+		{op: "read", expected: metadata.AttributeOperationRead, ok: true},
+		{op: "write", expected: metadata.AttributeOperationWrite, ok: true},
+		{op: "udf", expected: metadata.AttributeOperationUdf, ok: true},
+		{op: "batch-index", expected: metadata.AttributeOperationBatchIndex, ok: true},
+		{op: "proxy", ok: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.op, func(t *testing.T) {
+			attr, ok := operationAttribute(tc.op)
+			require.Equal(t, tc.ok, ok)
+			if tc.ok {
+				require.Equal(t, tc.expected, attr)
+			}
+		})
+	}
+}
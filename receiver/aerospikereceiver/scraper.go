@@ -0,0 +1,266 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aerospikereceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/aerospikereceiver"
+
+import (
+	"context"
+	"time"
+
+	as "github.com/aerospike/aerospike-client-go/v6"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver/scraperhelper"
+	"go.uber.org/multierr"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/aerospikereceiver/internal/metadata"
+)
+
+// aerospikeReceiver scrapes metrics from an Aerospike cluster by issuing info
+// commands against every node discovered from the configured seeds.
+type aerospikeReceiver struct {
+	seeds          []Endpoint
+	policy         *as.ClientPolicy
+	clusterFactory clusterFactoryFunc
+	config         *Config
+	mb             *metadata.MetricsBuilder
+	logger         *zap.SugaredLogger
+	client         Aerospike
+}
+
+func newAerospikeReceiver(settings component.ReceiverCreateSettings, config *Config, consumer consumer.Metrics) (component.MetricsReceiver, error) {
+	seeds, err := config.seedEndpoints()
+	if err != nil {
+		return nil, err
+	}
+
+	policy, err := clientPolicyFromConfig(config)
+	if err != nil {
+		return nil, err
+	}
+
+	asr := &aerospikeReceiver{
+		seeds:          seeds,
+		policy:         policy,
+		clusterFactory: newASClient,
+		config:         config,
+		mb:             metadata.NewMetricsBuilder(config.MetricsSettings, settings.BuildInfo),
+		logger:         settings.Logger.Sugar(),
+	}
+
+	scraper, err := scraperhelper.NewScraper(typeStr, asr.scrape,
+		scraperhelper.WithStart(asr.start),
+		scraperhelper.WithShutdown(asr.shutdown))
+	if err != nil {
+		return nil, err
+	}
+
+	return scraperhelper.NewScraperControllerReceiver(
+		&config.ScraperControllerSettings,
+		settings,
+		consumer,
+		scraperhelper.AddScraper(scraper),
+	)
+}
+
+// start connects to the Aerospike cluster via the configured seeds. A connection failure is
+// logged rather than returned so that the receiver can retry on the next scrape.
+func (r *aerospikeReceiver) start(_ context.Context, _ component.Host) error {
+	client, err := r.clusterFactory(r.seeds, r.policy)
+	if err != nil {
+		r.logger.Error("failed to connect to aerospike cluster, will retry on next scrape: ", err)
+		r.client = nil
+		return nil
+	}
+
+	r.client = client
+	return nil
+}
+
+func (r *aerospikeReceiver) shutdown(_ context.Context) error {
+	if r.client == nil {
+		return nil
+	}
+
+	return r.client.Close()
+}
+
+func (r *aerospikeReceiver) scrape(_ context.Context) (pmetric.Metrics, error) {
+	if r.client == nil {
+		client, err := r.clusterFactory(r.seeds, r.policy)
+		if err != nil {
+			r.logger.Error("failed to connect to aerospike cluster: ", err)
+			return r.mb.Emit(), nil
+		}
+
+		r.client = client
+	}
+
+	var errs error
+	now := pcommon.NewTimestampFromTime(time.Now().UTC())
+
+	info, err := r.client.Info()
+	if err != nil {
+		errs = multierr.Append(errs, err)
+	} else {
+		for node, metrics := range info {
+			if v, ok := metrics["client_connections"]; ok {
+				if recErr := r.mb.RecordAerospikeNodeConnectionOpenDataPoint(now, v, metadata.AttributeConnectionTypeClient); recErr != nil {
+					errs = multierr.Append(errs, recErr)
+				}
+			}
+
+			r.mb.EmitForResource(metadata.WithAerospikeNodeName(node))
+		}
+	}
+
+	if !r.config.CollectClusterMetrics {
+		return r.mb.Emit(), errs
+	}
+
+	nsInfo, err := r.client.NamespaceInfo()
+	if err != nil {
+		errs = multierr.Append(errs, err)
+		return r.mb.Emit(), errs
+	}
+
+	for node, namespaces := range nsInfo {
+		for ns, metrics := range namespaces {
+			if v, ok := metrics["memory_free_pct"]; ok {
+				if recErr := r.mb.RecordAerospikeNamespaceMemoryFreeDataPoint(now, v); recErr != nil {
+					errs = multierr.Append(errs, recErr)
+				}
+			}
+
+			if v, ok := metrics["memory_used_data_bytes"]; ok {
+				if recErr := r.mb.RecordAerospikeNamespaceMemoryUsageDataPoint(now, v, metadata.AttributeNamespaceComponentData); recErr != nil {
+					errs = multierr.Append(errs, recErr)
+				}
+			}
+
+			r.mb.EmitForResource(metadata.WithAerospikeNamespace(ns), metadata.WithAerospikeNodeName(node))
+		}
+	}
+
+	setInfo, err := r.client.SetInfo()
+	if err != nil {
+		errs = multierr.Append(errs, err)
+		return r.mb.Emit(), errs
+	}
+
+	for node, namespaces := range setInfo {
+		for ns, sets := range namespaces {
+			for set, metrics := range sets {
+				if v, ok := metrics["objects"]; ok {
+					if recErr := r.mb.RecordAerospikeSetObjectsCountDataPoint(now, v); recErr != nil {
+						errs = multierr.Append(errs, recErr)
+					}
+				}
+
+				if v, ok := metrics["tombstones"]; ok {
+					if recErr := r.mb.RecordAerospikeSetTombstonesCountDataPoint(now, v); recErr != nil {
+						errs = multierr.Append(errs, recErr)
+					}
+				}
+
+				if v, ok := metrics["memory_data_bytes"]; ok {
+					if recErr := r.mb.RecordAerospikeSetMemoryUsageDataPoint(now, v); recErr != nil {
+						errs = multierr.Append(errs, recErr)
+					}
+				}
+
+				if v, ok := metrics["truncate_lut"]; ok {
+					if recErr := r.mb.RecordAerospikeSetTruncateTimeDataPoint(now, v); recErr != nil {
+						errs = multierr.Append(errs, recErr)
+					}
+				}
+
+				r.mb.EmitForResource(metadata.WithAerospikeSet(set), metadata.WithAerospikeNamespace(ns), metadata.WithAerospikeNodeName(node))
+			}
+		}
+	}
+
+	latencyInfo, err := r.client.LatencyInfo()
+	if err != nil {
+		errs = multierr.Append(errs, err)
+	}
+
+	for node, namespaces := range latencyInfo {
+		for ns, operations := range namespaces {
+			for op, bucket := range operations {
+				attr, ok := operationAttribute(op)
+				if !ok {
+					continue
+				}
+
+				counts := latencyBucketCounts(bucket.TotalOpsPerSec, bucket.ExceedPcts, r.config.ScraperControllerSettings.CollectionInterval)
+				sum := latencySumEstimate(counts)
+
+				r.mb.RecordAerospikeNamespaceLatencyDataPoint(now, counts, sum, attr)
+			}
+
+			r.mb.EmitForResource(metadata.WithAerospikeNamespace(ns), metadata.WithAerospikeNodeName(node))
+		}
+	}
+
+	if !r.config.CollectXDRMetrics {
+		return r.mb.Emit(), errs
+	}
+
+	xdrInfo, err := r.client.XDRInfo()
+	if err != nil {
+		errs = multierr.Append(errs, err)
+	}
+
+	for node, datacenters := range xdrInfo {
+		for dc, metrics := range datacenters {
+			if v, ok := metrics["lag"]; ok {
+				if recErr := r.mb.RecordAerospikeXDRLagDataPoint(now, v); recErr != nil {
+					errs = multierr.Append(errs, recErr)
+				}
+			}
+
+			if v, ok := metrics["recoveries"]; ok {
+				if recErr := r.mb.RecordAerospikeXDRRecoveriesDataPoint(now, v); recErr != nil {
+					errs = multierr.Append(errs, recErr)
+				}
+			}
+
+			if v, ok := metrics["throughput"]; ok {
+				if recErr := r.mb.RecordAerospikeXDRThroughputDataPoint(now, v); recErr != nil {
+					errs = multierr.Append(errs, recErr)
+				}
+			}
+
+			if v, ok := metrics["latency_ms"]; ok {
+				if recErr := r.mb.RecordAerospikeXDRLatencyMsDataPoint(now, v); recErr != nil {
+					errs = multierr.Append(errs, recErr)
+				}
+			}
+
+			if v, ok := metrics["retry_no_node"]; ok {
+				if recErr := r.mb.RecordAerospikeXDRRetryNoNodeDataPoint(now, v); recErr != nil {
+					errs = multierr.Append(errs, recErr)
+				}
+			}
+
+			r.mb.EmitForResource(metadata.WithAerospikeXDRDatacenter(dc), metadata.WithAerospikeNodeName(node))
+		}
+	}
+
+	return r.mb.Emit(), errs
+}
@@ -21,12 +21,15 @@ import (
 	"testing"
 	"time"
 
+	as "github.com/aerospike/aerospike-client-go/v6"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config/configtls"
 	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver/scraperhelper"
 	"go.uber.org/zap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/scrapertest"
@@ -48,7 +51,7 @@ func TestNewAerospikeReceiver_BadEndpoint(t *testing.T) {
 		{
 			name:     "no address",
 			endpoint: "",
-			errMsg:   "missing port in address",
+			errMsg:   "must specify at least one of endpoint or seeds",
 		},
 	}
 
@@ -94,59 +97,82 @@ func TestScrape_CollectClusterMetrics(t *testing.T) {
 	// require.NoError(t, expectedMB.RecordAerospikeNamespaceMemoryUsageDataPoint(now, "badval", metadata.AttributeNamespaceComponentData))
 	// expectedMB.EmitForResource(metadata.WithAerospikeNamespace("bar"), metadata.WithAerospikeNodeName("BB990C28F270009"))
 
+	require.NoError(t, expectedMB.RecordAerospikeSetObjectsCountDataPoint(now, "5"))
+	require.NoError(t, expectedMB.RecordAerospikeSetTombstonesCountDataPoint(now, "0"))
+	require.NoError(t, expectedMB.RecordAerospikeSetMemoryUsageDataPoint(now, "100"))
+	require.NoError(t, expectedMB.RecordAerospikeSetTruncateTimeDataPoint(now, "0"))
+	expectedMB.EmitForResource(metadata.WithAerospikeSet("foo"), metadata.WithAerospikeNamespace("test"), metadata.WithAerospikeNodeName("BB990C28F270008"))
+
 	initialClient := mocks.NewAerospike(t)
-	initialClient.On("Info").Return(clusterInfo{
-		"BB990C28F270008": metricsMap{
+	initialClient.On("Info").Return(ClusterInfo{
+		"BB990C28F270008": MetricsMap{
 			"node":               "BB990C28F270008",
 			"client_connections": "22",
 		},
-		"BB990C28F270009": metricsMap{
+		"BB990C28F270009": MetricsMap{
 			"node":               "BB990C28F270009",
 			"client_connections": "1",
 		},
 	}, nil)
 
-	initialClient.On("NamespaceInfo").Return(namespaceInfo{
+	initialClient.On("NamespaceInfo").Return(NamespaceInfo{
 		"BB990C28F270008": map[string]map[string]string{
-			"test": metricsMap{
+			"test": MetricsMap{
 				"name":            "test",
 				"memory_free_pct": "45",
 			},
-			"bar": metricsMap{
+			"bar": MetricsMap{
 				"name":            "bar",
 				"memory_free_pct": "30",
 			},
 		},
 		"BB990C28F270009": map[string]map[string]string{
-			"test": metricsMap{
+			"test": MetricsMap{
 				"name":                   "test",
 				"memory_used_data_bytes": "128",
 			},
-			"bar": metricsMap{
+			"bar": MetricsMap{
 				"name":                   "bar",
 				"memory_used_data_bytes": "badval",
 			},
 		},
 	}, nil)
 
+	initialClient.On("SetInfo").Return(SetsInfo{
+		"BB990C28F270008": map[string]map[string]MetricsMap{
+			"test": {
+				"foo": MetricsMap{
+					"set":               "foo",
+					"objects":           "5",
+					"tombstones":        "0",
+					"memory_data_bytes": "100",
+					"truncate_lut":      "0",
+				},
+			},
+		},
+		"BB990C28F270009": map[string]map[string]MetricsMap{},
+	}, nil)
+
+	initialClient.On("LatencyInfo").Return(LatencyInfo{}, nil)
+
 	initialClient.On("Close").Return(nil)
 
-	clientFactory := func(host string, port int) (Aerospike, error) {
-		switch fmt.Sprintf("%s:%d", host, port) {
-		case "localhost:3000":
-			return initialClient, nil
-		case "localhost:3002":
-			return nil, errors.New("connection timeout")
+	// clusterFactory emulates the real Aerospike client's seed behavior: it connects
+	// successfully as long as any one of the given seeds is reachable.
+	clusterFactory := func(seeds []Endpoint, _ *as.ClientPolicy) (Aerospike, error) {
+		for _, seed := range seeds {
+			if fmt.Sprintf("%s:%d", seed.Host, seed.Port) == "localhost:3000" {
+				return initialClient, nil
+			}
 		}
 
-		return nil, errors.New("unexpected endpoint")
+		return nil, errors.New("connection timeout")
 	}
 	receiver := &aerospikeReceiver{
-		host:          "localhost",
-		port:          3000,
-		clientFactory: clientFactory,
-		mb:            metadata.NewMetricsBuilder(metadata.DefaultMetricsSettings(), component.NewDefaultBuildInfo()),
-		logger:        logger.Sugar(),
+		seeds:          []Endpoint{{Host: "localhost", Port: 3000}},
+		clusterFactory: clusterFactory,
+		mb:             metadata.NewMetricsBuilder(metadata.DefaultMetricsSettings(), component.NewDefaultBuildInfo()),
+		logger:         logger.Sugar(),
 		config: &Config{
 			CollectClusterMetrics: true,
 		},
@@ -162,23 +188,260 @@ func TestScrape_CollectClusterMetrics(t *testing.T) {
 
 	require.NoError(t, receiver.shutdown(context.Background()))
 
-	initialClient.AssertExpectations(t)
+	initialClient.AssertNumberOfCalls(t, "Close", 1)
 
 	receiverConnErr := &aerospikeReceiver{
-		host:          "localhost",
-		port:          3002,
-		clientFactory: clientFactory,
-		mb:            metadata.NewMetricsBuilder(metadata.DefaultMetricsSettings(), component.NewDefaultBuildInfo()),
-		logger:        logger.Sugar(),
+		seeds:          []Endpoint{{Host: "localhost", Port: 3002}},
+		clusterFactory: clusterFactory,
+		mb:             metadata.NewMetricsBuilder(metadata.DefaultMetricsSettings(), component.NewDefaultBuildInfo()),
+		logger:         logger.Sugar(),
 		config: &Config{
 			CollectClusterMetrics: true,
 		},
 	}
 
-	initialClient.AssertNumberOfCalls(t, "Close", 1)
-
 	err = receiverConnErr.start(context.Background(), componenttest.NewNopHost())
 	require.NoError(t, err)
 	require.Equal(t, receiverConnErr.client, nil, "client should be set to nil because of connection error")
 
+	// A second, down seed alongside the working one should not prevent discovery:
+	// the cluster is still reachable through localhost:3000.
+	receiverMultiSeed := &aerospikeReceiver{
+		seeds:          []Endpoint{{Host: "localhost", Port: 3002}, {Host: "localhost", Port: 3000}},
+		clusterFactory: clusterFactory,
+		mb:             metadata.NewMetricsBuilder(metadata.DefaultMetricsSettings(), component.NewDefaultBuildInfo()),
+		logger:         logger.Sugar(),
+		config: &Config{
+			CollectClusterMetrics: true,
+		},
+	}
+
+	require.NoError(t, receiverMultiSeed.start(context.Background(), componenttest.NewNopHost()))
+	require.NotNil(t, receiverMultiSeed.client, "client should discover the cluster via the reachable seed")
+
+	actualMultiSeedMetrics, err := receiverMultiSeed.scrape(context.Background())
+	require.EqualError(t, err, "failed to parse int64 for AerospikeNamespaceMemoryUsage, value was badval: strconv.ParseInt: parsing \"badval\": invalid syntax")
+	require.NoError(t, scrapertest.CompareMetrics(expectedMetrics, actualMultiSeedMetrics))
+
+	require.NoError(t, receiverMultiSeed.shutdown(context.Background()))
+
+	initialClient.AssertNumberOfCalls(t, "Close", 2)
+	initialClient.AssertExpectations(t)
+}
+
+func TestScrape_CollectXDRMetrics(t *testing.T) {
+	t.Parallel()
+
+	logger, err := zap.NewDevelopment()
+	require.NoError(t, err)
+	now := pcommon.NewTimestampFromTime(time.Now().UTC())
+
+	expectedMB := metadata.NewMetricsBuilder(metadata.DefaultMetricsSettings(), component.NewDefaultBuildInfo())
+
+	require.NoError(t, expectedMB.RecordAerospikeXDRLagDataPoint(now, "2"))
+	require.NoError(t, expectedMB.RecordAerospikeXDRRecoveriesDataPoint(now, "0"))
+	require.NoError(t, expectedMB.RecordAerospikeXDRThroughputDataPoint(now, "1000"))
+	require.NoError(t, expectedMB.RecordAerospikeXDRLatencyMsDataPoint(now, "5"))
+	require.NoError(t, expectedMB.RecordAerospikeXDRRetryNoNodeDataPoint(now, "1"))
+	expectedMB.EmitForResource(metadata.WithAerospikeXDRDatacenter("DC1"), metadata.WithAerospikeNodeName("BB990C28F270008"))
+
+	require.NoError(t, expectedMB.RecordAerospikeXDRLagDataPoint(now, "120"))
+	require.NoError(t, expectedMB.RecordAerospikeXDRRecoveriesDataPoint(now, "4"))
+	require.NoError(t, expectedMB.RecordAerospikeXDRThroughputDataPoint(now, "0"))
+	require.NoError(t, expectedMB.RecordAerospikeXDRLatencyMsDataPoint(now, "0"))
+	require.NoError(t, expectedMB.RecordAerospikeXDRRetryNoNodeDataPoint(now, "9"))
+	expectedMB.EmitForResource(metadata.WithAerospikeXDRDatacenter("DC2"), metadata.WithAerospikeNodeName("BB990C28F270008"))
+	// DC3 is configured but unreachable from this node, so it contributes no data points.
+
+	client := mocks.NewAerospike(t)
+	client.On("Info").Return(ClusterInfo{}, nil)
+	client.On("NamespaceInfo").Return(NamespaceInfo{}, nil)
+	client.On("SetInfo").Return(SetsInfo{}, nil)
+	client.On("LatencyInfo").Return(LatencyInfo{}, nil)
+	client.On("XDRInfo").Return(XDRInfo{
+		"BB990C28F270008": map[string]MetricsMap{
+			"DC1": {
+				"lag":           "2",
+				"recoveries":    "0",
+				"throughput":    "1000",
+				"latency_ms":    "5",
+				"retry_no_node": "1",
+			},
+			"DC2": {
+				"lag":           "120",
+				"recoveries":    "4",
+				"throughput":    "0",
+				"latency_ms":    "0",
+				"retry_no_node": "9",
+			},
+		},
+	}, errors.New("failed to get xdr stats for dc DC3: connection timeout"))
+	client.On("Close").Return(nil)
+
+	clusterFactory := func(seeds []Endpoint, _ *as.ClientPolicy) (Aerospike, error) {
+		return client, nil
+	}
+
+	receiver := &aerospikeReceiver{
+		seeds:          []Endpoint{{Host: "localhost", Port: 3000}},
+		clusterFactory: clusterFactory,
+		mb:             metadata.NewMetricsBuilder(metadata.DefaultMetricsSettings(), component.NewDefaultBuildInfo()),
+		logger:         logger.Sugar(),
+		config: &Config{
+			CollectClusterMetrics: true,
+			CollectXDRMetrics:     true,
+		},
+	}
+
+	require.NoError(t, receiver.start(context.Background(), componenttest.NewNopHost()))
+
+	actualMetrics, err := receiver.scrape(context.Background())
+	require.EqualError(t, err, "failed to get xdr stats for dc DC3: connection timeout")
+
+	expectedMetrics := expectedMB.Emit()
+	require.NoError(t, scrapertest.CompareMetrics(expectedMetrics, actualMetrics))
+
+	require.NoError(t, receiver.shutdown(context.Background()))
+
+	client.AssertExpectations(t)
+}
+
+func TestScrape_CollectLatencyMetrics(t *testing.T) {
+	t.Parallel()
+
+	logger, err := zap.NewDevelopment()
+	require.NoError(t, err)
+	now := pcommon.NewTimestampFromTime(time.Now().UTC())
+	interval := 10 * time.Second
+
+	// 1000 ops/sec over a 10s scrape interval is 10000 ops. The exceed percentages
+	// decrease by a constant 5 points per boundary, so every bucket but the overflow
+	// bucket holds 5% (500 ops) and the overflow bucket holds the remaining 15% (1500 ops).
+	readExceedPcts := []float64{95, 90, 85, 80, 75, 70, 65, 60, 55, 50, 45, 40, 35, 30, 25, 20, 15}
+	readCounts := []uint64{500, 500, 500, 500, 500, 500, 500, 500, 500, 500, 500, 500, 500, 500, 500, 500, 500, 1500}
+	readSum := latencySumEstimate(readCounts)
+
+	expectedMB := metadata.NewMetricsBuilder(metadata.DefaultMetricsSettings(), component.NewDefaultBuildInfo())
+	expectedMB.RecordAerospikeNamespaceLatencyDataPoint(now, readCounts, readSum, metadata.AttributeOperationRead)
+	expectedMB.EmitForResource(metadata.WithAerospikeNamespace("test"), metadata.WithAerospikeNodeName("BB990C28F270008"))
+
+	client := mocks.NewAerospike(t)
+	client.On("Info").Return(ClusterInfo{}, nil)
+	client.On("NamespaceInfo").Return(NamespaceInfo{}, nil)
+	client.On("SetInfo").Return(SetsInfo{}, nil)
+	client.On("LatencyInfo").Return(LatencyInfo{
+		"BB990C28F270008": map[string]map[string]LatencyBucket{
+			"test": {
+				"read": {
+					TotalOpsPerSec: 1000,
+					ExceedPcts:     readExceedPcts,
+				},
+				// proxy is not a recognized operation and is dropped.
+				"proxy": {
+					TotalOpsPerSec: 10,
+					ExceedPcts:     readExceedPcts,
+				},
+			},
+		},
+	}, nil)
+	client.On("Close").Return(nil)
+
+	clusterFactory := func(seeds []Endpoint, _ *as.ClientPolicy) (Aerospike, error) {
+		return client, nil
+	}
+
+	receiver := &aerospikeReceiver{
+		seeds:          []Endpoint{{Host: "localhost", Port: 3000}},
+		clusterFactory: clusterFactory,
+		mb:             metadata.NewMetricsBuilder(metadata.DefaultMetricsSettings(), component.NewDefaultBuildInfo()),
+		logger:         logger.Sugar(),
+		config: &Config{
+			CollectClusterMetrics: true,
+			ScraperControllerSettings: scraperhelper.ScraperControllerSettings{
+				CollectionInterval: interval,
+			},
+		},
+	}
+
+	require.NoError(t, receiver.start(context.Background(), componenttest.NewNopHost()))
+
+	actualMetrics, err := receiver.scrape(context.Background())
+	require.NoError(t, err)
+
+	expectedMetrics := expectedMB.Emit()
+	require.NoError(t, scrapertest.CompareMetrics(expectedMetrics, actualMetrics))
+
+	require.NoError(t, receiver.shutdown(context.Background()))
+
+	client.AssertExpectations(t)
+}
+
+func TestClientPolicyFromConfig(t *testing.T) {
+	testCases := []struct {
+		name   string
+		cfg    *Config
+		verify func(t *testing.T, policy *as.ClientPolicy)
+	}{
+		{
+			name: "TLS only",
+			cfg: &Config{
+				TLS: &configtls.TLSClientSetting{
+					TLSSetting: configtls.TLSSetting{
+						CAFile: "ca.pem",
+					},
+					ServerName: "aerospike.example.com",
+				},
+			},
+			verify: func(t *testing.T, policy *as.ClientPolicy) {
+				require.NotNil(t, policy.TlsConfig)
+				require.Equal(t, as.AuthModeInternal, policy.AuthMode)
+			},
+		},
+		{
+			name: "mTLS with client cert",
+			cfg: &Config{
+				TLS: &configtls.TLSClientSetting{
+					TLSSetting: configtls.TLSSetting{
+						CAFile:   "ca.pem",
+						CertFile: "client.pem",
+						KeyFile:  "client-key.pem",
+					},
+					Insecure:           false,
+					InsecureSkipVerify: false,
+					ServerName:         "aerospike.example.com",
+				},
+				Auth: &AuthConfig{
+					AuthMode: AuthModePKI,
+				},
+			},
+			verify: func(t *testing.T, policy *as.ClientPolicy) {
+				require.NotNil(t, policy.TlsConfig)
+				require.Equal(t, as.AuthModePKI, policy.AuthMode)
+			},
+		},
+		{
+			name: "SCRAM authenticated, no TLS",
+			cfg: &Config{
+				Auth: &AuthConfig{
+					Username: "otel",
+					Password: "changeit",
+					AuthMode: AuthModeInternal,
+				},
+			},
+			verify: func(t *testing.T, policy *as.ClientPolicy) {
+				require.Nil(t, policy.TlsConfig)
+				require.Equal(t, "otel", policy.User)
+				require.Equal(t, "changeit", policy.Password)
+				require.Equal(t, as.AuthModeInternal, policy.AuthMode)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			policy, err := clientPolicyFromConfig(tc.cfg)
+			require.NoError(t, err)
+			tc.verify(t, policy)
+		})
+	}
 }
\ No newline at end of file